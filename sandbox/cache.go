@@ -0,0 +1,116 @@
+package sandbox
+
+import (
+	"sync"
+	"time"
+)
+
+// resourceKind identifies which kind of CF resource a cache entry holds
+type resourceKind string
+
+const (
+	resourceKindApps       resourceKind = "apps"
+	resourceKindInstances  resourceKind = "service_instances"
+	resourceKindSpaces     resourceKind = "spaces"
+	resourceKindSpaceRoles resourceKind = "space_roles"
+)
+
+// cacheKey identifies a cache entry. resourceID scopes entries that aren't
+// org-wide, e.g. space roles are fetched per space; org-wide kinds (apps,
+// service instances, spaces) leave it empty.
+type cacheKey struct {
+	orgGUID    string
+	kind       resourceKind
+	resourceID string
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// CacheOptions configures the resource cache placed in front of go-cfclient calls
+type CacheOptions struct {
+	CacheEnabled bool          `envconfig:"cache_enabled" default:"true"`
+	CacheTTL     time.Duration `envconfig:"cache_ttl" default:"60s"`
+}
+
+// resourceCache caches go-cfclient responses keyed by org GUID and resource
+// kind, so a scheduler tick doesn't have to re-hit the CF API for every org
+// on every run. A nil *resourceCache or one with enabled=false always misses.
+type resourceCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	enabled bool
+	entries map[cacheKey]cacheEntry
+}
+
+// NewResourceCache creates a resourceCache from CacheOptions. Pass
+// CacheEnabled=false to disable caching entirely while keeping the same call
+// sites (every lookup misses and falls through to the CF API).
+func NewResourceCache(opts CacheOptions) *resourceCache {
+	return &resourceCache{
+		ttl:     opts.CacheTTL,
+		enabled: opts.CacheEnabled,
+		entries: map[cacheKey]cacheEntry{},
+	}
+}
+
+func (c *resourceCache) get(orgGUID string, kind resourceKind, resourceID string, now time.Time) (interface{}, bool) {
+	if c == nil || !c.enabled {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[cacheKey{orgGUID, kind, resourceID}]
+	if !ok || now.After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *resourceCache) set(orgGUID string, kind resourceKind, resourceID string, value interface{}, now time.Time) {
+	if c == nil || !c.enabled {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[cacheKey{orgGUID, kind, resourceID}] = cacheEntry{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+// invalidate drops every cached entry for an org GUID and resource kind,
+// regardless of resourceID
+func (c *resourceCache) invalidate(orgGUID string, kind resourceKind) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.orgGUID == orgGUID && key.kind == kind {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// invalidateOrg drops every cached resource kind for an org GUID
+func (c *resourceCache) invalidateOrg(orgGUID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.orgGUID == orgGUID {
+			delete(c.entries, key)
+		}
+	}
+}
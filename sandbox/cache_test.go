@@ -0,0 +1,113 @@
+package sandbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResourceCacheHitWithinTTL(t *testing.T) {
+	cache := NewResourceCache(CacheOptions{CacheEnabled: true, CacheTTL: time.Minute})
+	now := time.Now()
+
+	cache.set("org-1", resourceKindApps, "", "apps-value", now)
+
+	value, ok := cache.get("org-1", resourceKindApps, "", now.Add(30*time.Second))
+	if !ok {
+		t.Fatal("expected a cache hit within the TTL window")
+	}
+	if value != "apps-value" {
+		t.Errorf("expected cached value %q, got %v", "apps-value", value)
+	}
+}
+
+func TestResourceCacheMissAfterTTL(t *testing.T) {
+	cache := NewResourceCache(CacheOptions{CacheEnabled: true, CacheTTL: time.Minute})
+	now := time.Now()
+
+	cache.set("org-1", resourceKindApps, "", "apps-value", now)
+
+	if _, ok := cache.get("org-1", resourceKindApps, "", now.Add(2*time.Minute)); ok {
+		t.Error("expected a cache miss once the TTL has elapsed")
+	}
+}
+
+func TestResourceCacheDisabledAlwaysMisses(t *testing.T) {
+	cache := NewResourceCache(CacheOptions{CacheEnabled: false, CacheTTL: time.Minute})
+	now := time.Now()
+
+	cache.set("org-1", resourceKindApps, "", "apps-value", now)
+
+	if _, ok := cache.get("org-1", resourceKindApps, "", now); ok {
+		t.Error("expected a disabled cache to always miss")
+	}
+}
+
+func TestNilResourceCacheAlwaysMisses(t *testing.T) {
+	var cache *resourceCache
+	now := time.Now()
+
+	cache.set("org-1", resourceKindApps, "", "apps-value", now)
+
+	if _, ok := cache.get("org-1", resourceKindApps, "", now); ok {
+		t.Error("expected a nil cache to always miss")
+	}
+}
+
+func TestResourceCacheInvalidateDropsOneKind(t *testing.T) {
+	cache := NewResourceCache(CacheOptions{CacheEnabled: true, CacheTTL: time.Minute})
+	now := time.Now()
+
+	cache.set("org-1", resourceKindApps, "", "apps-value", now)
+	cache.set("org-1", resourceKindSpaces, "", "spaces-value", now)
+
+	cache.invalidate("org-1", resourceKindApps)
+
+	if _, ok := cache.get("org-1", resourceKindApps, "", now); ok {
+		t.Error("expected apps entry to be invalidated")
+	}
+	if _, ok := cache.get("org-1", resourceKindSpaces, "", now); !ok {
+		t.Error("expected spaces entry to survive invalidating apps")
+	}
+}
+
+func TestResourceCacheInvalidateCoversAllResourceIDs(t *testing.T) {
+	cache := NewResourceCache(CacheOptions{CacheEnabled: true, CacheTTL: time.Minute})
+	now := time.Now()
+
+	cache.set("org-1", resourceKindSpaceRoles, "space-a", "roles-a", now)
+	cache.set("org-1", resourceKindSpaceRoles, "space-b", "roles-b", now)
+
+	cache.invalidate("org-1", resourceKindSpaceRoles)
+
+	if _, ok := cache.get("org-1", resourceKindSpaceRoles, "space-a", now); ok {
+		t.Error("expected space-a roles to be invalidated")
+	}
+	if _, ok := cache.get("org-1", resourceKindSpaceRoles, "space-b", now); ok {
+		t.Error("expected space-b roles to be invalidated")
+	}
+}
+
+func TestResourceCacheInvalidateOrgDropsEveryKind(t *testing.T) {
+	cache := NewResourceCache(CacheOptions{CacheEnabled: true, CacheTTL: time.Minute})
+	now := time.Now()
+
+	cache.set("org-1", resourceKindApps, "", "apps-value", now)
+	cache.set("org-1", resourceKindInstances, "", "instances-value", now)
+	cache.set("org-1", resourceKindSpaces, "", "spaces-value", now)
+	cache.set("org-2", resourceKindApps, "", "other-org-apps", now)
+
+	cache.invalidateOrg("org-1")
+
+	if _, ok := cache.get("org-1", resourceKindApps, "", now); ok {
+		t.Error("expected org-1 apps entry to be invalidated")
+	}
+	if _, ok := cache.get("org-1", resourceKindInstances, "", now); ok {
+		t.Error("expected org-1 instances entry to be invalidated")
+	}
+	if _, ok := cache.get("org-1", resourceKindSpaces, "", now); ok {
+		t.Error("expected org-1 spaces entry to be invalidated")
+	}
+	if _, ok := cache.get("org-2", resourceKindApps, "", now); !ok {
+		t.Error("expected org-2 entry to be untouched by invalidating org-1")
+	}
+}
@@ -0,0 +1,58 @@
+package sandbox
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/cloudfoundry-community/go-cfclient"
+)
+
+// clientMu serializes access to a *cfclient.Client's underlying HTTP
+// transport while withDeadline has it swapped out, since that transport is
+// state shared across every call made with the client.
+var clientMu sync.Mutex
+
+// contextRoundTripper binds every outgoing request to ctx, so the standard
+// library's own request cancellation aborts the call the moment ctx is
+// canceled or its deadline passes, rather than abandoning it to run to
+// completion in the background.
+type contextRoundTripper struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (rt *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt.base.RoundTrip(req.WithContext(rt.ctx))
+}
+
+// withDeadline runs fn with client's requests bound to ctx, giving
+// go-cfclient real per-request cancellation despite having no
+// context-aware request variants of its own. It temporarily swaps in a
+// contextRoundTripper and restores the original transport before returning;
+// clientMu guards that swap against concurrent withDeadline calls sharing
+// the same client (e.g. two goroutines in a scheduler's per-space loop).
+func withDeadline(ctx context.Context, client *cfclient.Client, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	httpClient := client.Config.HttpClient
+	if httpClient == nil {
+		return fn()
+	}
+
+	clientMu.Lock()
+	defer clientMu.Unlock()
+
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	httpClient.Transport = &contextRoundTripper{ctx: ctx, base: base}
+	defer func() {
+		httpClient.Transport = base
+	}()
+
+	return fn()
+}
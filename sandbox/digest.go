@@ -0,0 +1,87 @@
+package sandbox
+
+import (
+	"context"
+	"html/template"
+	"time"
+)
+
+// Digest outcomes for a single space in a purge/notify run
+const (
+	DigestOutcomeNotified = "notified"
+	DigestOutcomePurged   = "purged"
+	DigestOutcomeFailed   = "failed"
+)
+
+// DigestOptions configures the admin summary email sent after a purge/notify run
+type DigestOptions struct {
+	DigestRecipients []string `envconfig:"digest_recipients"`
+}
+
+// DigestEntry summarizes what happened to a single space during a purge/notify run
+type DigestEntry struct {
+	Org            string
+	Space          string
+	FirstResource  time.Time
+	DaysUntilPurge int
+	Outcome        string
+}
+
+// CollectDigestEntries converts one org's ListPurgeSpaces results into
+// DigestEntry rows, computing days-until-purge for notified spaces and
+// defaulting every purged space's outcome to DigestOutcomePurged. Callers
+// that track per-space purge failures should overwrite Outcome on the
+// returned entries to DigestOutcomeFailed before handing them to
+// RenderDigest. Call once per org and append the results across a run to
+// build the full digest.
+func CollectDigestEntries(org string, toNotify, toPurge []SpaceDetails, now time.Time, purgeThreshold int) []DigestEntry {
+	entries := make([]DigestEntry, 0, len(toNotify)+len(toPurge))
+
+	for _, details := range toNotify {
+		daysElapsed := int(now.Sub(details.Timestamp).Hours() / 24)
+		entries = append(entries, DigestEntry{
+			Org:            org,
+			Space:          details.Space.Name,
+			FirstResource:  details.Timestamp,
+			DaysUntilPurge: purgeThreshold - daysElapsed,
+			Outcome:        DigestOutcomeNotified,
+		})
+	}
+
+	for _, details := range toPurge {
+		entries = append(entries, DigestEntry{
+			Org:            org,
+			Space:          details.Space.Name,
+			FirstResource:  details.Timestamp,
+			DaysUntilPurge: 0,
+			Outcome:        DigestOutcomePurged,
+		})
+	}
+
+	return entries
+}
+
+// RenderDigest renders the admin summary template for a batch of digest entries
+func RenderDigest(tmpl *template.Template, entries []DigestEntry) (string, error) {
+	return RenderTemplate(tmpl, map[string]interface{}{"Entries": entries})
+}
+
+// SendDigest sends the rendered admin summary to the configured digest recipients
+func SendDigest(
+	ctx context.Context,
+	mailer Mailer,
+	sender string,
+	subject string,
+	body string,
+	opts DigestOptions,
+) error {
+	if len(opts.DigestRecipients) == 0 {
+		return nil
+	}
+	return mailer.Send(ctx, Message{
+		Sender:     sender,
+		Subject:    subject,
+		Body:       body,
+		Recipients: opts.DigestRecipients,
+	})
+}
@@ -0,0 +1,48 @@
+package sandbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient"
+)
+
+func TestCollectDigestEntries(t *testing.T) {
+	now := time.Date(2026, 7, 27, 0, 0, 0, 0, time.UTC)
+	notifySpace := SpaceDetails{
+		Timestamp: now.AddDate(0, 0, -5),
+		Space:     cfclient.Space{Name: "notify-me"},
+	}
+	purgeSpace := SpaceDetails{
+		Timestamp: now.AddDate(0, 0, -10),
+		Space:     cfclient.Space{Name: "purge-me"},
+	}
+
+	entries := CollectDigestEntries("my-org", []SpaceDetails{notifySpace}, []SpaceDetails{purgeSpace}, now, 10)
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	notified := entries[0]
+	if notified.Org != "my-org" || notified.Space != "notify-me" {
+		t.Errorf("unexpected notified entry: %+v", notified)
+	}
+	if notified.Outcome != DigestOutcomeNotified {
+		t.Errorf("expected outcome %q, got %q", DigestOutcomeNotified, notified.Outcome)
+	}
+	if notified.DaysUntilPurge != 5 {
+		t.Errorf("expected 5 days until purge, got %d", notified.DaysUntilPurge)
+	}
+
+	purged := entries[1]
+	if purged.Org != "my-org" || purged.Space != "purge-me" {
+		t.Errorf("unexpected purged entry: %+v", purged)
+	}
+	if purged.Outcome != DigestOutcomePurged {
+		t.Errorf("expected outcome %q, got %q", DigestOutcomePurged, purged.Outcome)
+	}
+	if purged.DaysUntilPurge != 0 {
+		t.Errorf("expected 0 days until purge, got %d", purged.DaysUntilPurge)
+	}
+}
@@ -0,0 +1,107 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"log"
+	"strings"
+)
+
+// DefaultLocale is the BCP-47 tag used when a recipient has no locale hint
+// or no template is registered for their locale
+const DefaultLocale = "en"
+
+// defaultNotifyTemplate is the built-in English fallback used when no other
+// locale-specific template is configured
+const defaultNotifyTemplate = `<p>Your sandbox space {{.Space}} in organization {{.Org}} will be purged soon due to inactivity.</p>`
+
+// Recipient is an email address paired with its preferred locale
+type Recipient struct {
+	Address string
+	Locale  string
+}
+
+// localeFromUsername derives a BCP-47 locale hint from a "+locale" suffix on
+// the local part of an email address, e.g. "jane+es@agency.gov" -> "es".
+// Addresses without the suffix fall back to DefaultLocale.
+func localeFromUsername(username string) string {
+	local := username
+	if at := strings.Index(username, "@"); at >= 0 {
+		local = username[:at]
+	}
+	if plus := strings.LastIndex(local, "+"); plus >= 0 {
+		if locale := local[plus+1:]; locale != "" {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// TemplateSet maps a BCP-47 language tag to the template rendered for that locale
+type TemplateSet map[string]*template.Template
+
+// Template returns the template registered for locale, falling back to
+// DefaultLocale when no localized version exists
+func (ts TemplateSet) Template(locale string) (*template.Template, bool) {
+	if tmpl, ok := ts[locale]; ok {
+		return tmpl, true
+	}
+	tmpl, ok := ts[DefaultLocale]
+	return tmpl, ok
+}
+
+// DefaultTemplateSet returns a TemplateSet containing only the built-in
+// English fallback template
+func DefaultTemplateSet() (TemplateSet, error) {
+	tmpl, err := template.New(DefaultLocale).Parse(defaultNotifyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return TemplateSet{DefaultLocale: tmpl}, nil
+}
+
+// SendLocalizedMail groups recipients by locale, renders templates once per
+// locale bucket, and sends one message per bucket
+func SendLocalizedMail(
+	ctx context.Context,
+	mailer Mailer,
+	templates TemplateSet,
+	data map[string]interface{},
+	sender string,
+	subject string,
+	recipients []Recipient,
+) error {
+	buckets := map[string][]string{}
+	for _, recipient := range recipients {
+		buckets[recipient.Locale] = append(buckets[recipient.Locale], recipient.Address)
+	}
+
+	var droppedLocales []string
+	for locale, addresses := range buckets {
+		tmpl, ok := templates.Template(locale)
+		if !ok {
+			log.Printf("sandbox: no template for locale %q and no default template configured; dropping %d recipient(s): %v", locale, len(addresses), addresses)
+			droppedLocales = append(droppedLocales, locale)
+			continue
+		}
+		body, err := RenderTemplate(tmpl, data)
+		if err != nil {
+			return err
+		}
+		if err := mailer.Send(ctx, Message{
+			Sender:     sender,
+			Subject:    subject,
+			Body:       body,
+			Recipients: addresses,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(droppedLocales) > 0 {
+		return fmt.Errorf("sandbox: no template for locale(s) %s and no default template configured", strings.Join(droppedLocales, ", "))
+	}
+
+	return nil
+}
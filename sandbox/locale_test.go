@@ -0,0 +1,103 @@
+package sandbox
+
+import (
+	"context"
+	"html/template"
+	"testing"
+)
+
+func TestLocaleFromUsername(t *testing.T) {
+	cases := []struct {
+		username string
+		want     string
+	}{
+		{"jane@agency.gov", DefaultLocale},
+		{"carlos+es@agency.gov", "es"},
+		{"not-an-email", DefaultLocale},
+	}
+	for _, c := range cases {
+		if got := localeFromUsername(c.username); got != c.want {
+			t.Errorf("localeFromUsername(%q) = %q, want %q", c.username, got, c.want)
+		}
+	}
+}
+
+func TestSendLocalizedMailBucketsByLocale(t *testing.T) {
+	en, err := template.New("en").Parse("Hello {{.Name}}")
+	if err != nil {
+		t.Fatalf("parse en template: %v", err)
+	}
+	es, err := template.New("es").Parse("Hola {{.Name}}")
+	if err != nil {
+		t.Fatalf("parse es template: %v", err)
+	}
+	templates := TemplateSet{
+		DefaultLocale: en,
+		"es":          es,
+	}
+
+	recipients := []Recipient{
+		{Address: "jane@agency.gov", Locale: DefaultLocale},
+		{Address: "carlos@agency.gov", Locale: "es"},
+		{Address: "amir@agency.gov", Locale: "fr"}, // no fr template, falls back to default
+	}
+
+	mailer := &NullMailer{}
+	data := map[string]interface{}{"Name": "Sandbox"}
+	err = SendLocalizedMail(context.Background(), mailer, templates, data, "sandbox@example.gov", "subject", recipients)
+	if err != nil {
+		t.Fatalf("SendLocalizedMail returned error: %v", err)
+	}
+
+	// Buckets are keyed by each recipient's locale, so en, es, and fr are
+	// three buckets and three messages; fr falls back to the English template.
+	if len(mailer.Sent) != 3 {
+		t.Fatalf("expected 3 messages (one per locale bucket), got %d", len(mailer.Sent))
+	}
+
+	gotRecipients := map[string]string{}
+	for _, msg := range mailer.Sent {
+		for _, addr := range msg.Recipients {
+			gotRecipients[addr] = msg.Body
+		}
+	}
+
+	if gotRecipients["jane@agency.gov"] != "Hello Sandbox" {
+		t.Errorf("expected English body for jane, got %q", gotRecipients["jane@agency.gov"])
+	}
+	if gotRecipients["carlos@agency.gov"] != "Hola Sandbox" {
+		t.Errorf("expected Spanish body for carlos, got %q", gotRecipients["carlos@agency.gov"])
+	}
+	if gotRecipients["amir@agency.gov"] != "Hello Sandbox" {
+		t.Errorf("expected fallback English body for amir, got %q", gotRecipients["amir@agency.gov"])
+	}
+}
+
+func TestSendLocalizedMailErrorsOnMissingDefaultTemplate(t *testing.T) {
+	es, err := template.New("es").Parse("Hola {{.Name}}")
+	if err != nil {
+		t.Fatalf("parse es template: %v", err)
+	}
+	// No DefaultLocale entry, so a "fr" recipient has nowhere to fall back to.
+	templates := TemplateSet{"es": es}
+
+	recipients := []Recipient{
+		{Address: "carlos@agency.gov", Locale: "es"},
+		{Address: "amir@agency.gov", Locale: "fr"},
+	}
+
+	mailer := &NullMailer{}
+	data := map[string]interface{}{"Name": "Sandbox"}
+	err = SendLocalizedMail(context.Background(), mailer, templates, data, "sandbox@example.gov", "subject", recipients)
+	if err == nil {
+		t.Fatal("expected an error for the locale with no matching or default template")
+	}
+
+	// The es bucket should still have been sent despite the fr bucket failing.
+	if len(mailer.Sent) != 1 {
+		t.Fatalf("expected 1 message to still be sent, got %d", len(mailer.Sent))
+	}
+	if mailer.Sent[0].Body != "Hola Sandbox" {
+		t.Errorf("expected Spanish body, got %q", mailer.Sent[0].Body)
+	}
+}
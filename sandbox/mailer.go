@@ -0,0 +1,111 @@
+package sandbox
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"log"
+	"sync"
+
+	"gopkg.in/gomail.v2"
+)
+
+// Message is a rendered email ready to be handed to a Mailer
+type Message struct {
+	Sender     string
+	Subject    string
+	Body       string
+	Recipients []string
+}
+
+// Mailer sends rendered messages to recipients. Implementations let callers
+// swap a real SMTP connection for a no-op sink, e.g. for a --dry-run mode.
+type Mailer interface {
+	// Dial establishes whatever connection Send will need
+	Dial(ctx context.Context) error
+	// Send delivers msg
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPMailer sends mail over SMTP using gomail
+type SMTPMailer struct {
+	dialer *gomail.Dialer
+	conn   gomail.SendCloser
+}
+
+// NewSMTPMailer creates a Mailer backed by a real SMTP connection
+func NewSMTPMailer(opts SMTPOptions) *SMTPMailer {
+	d := gomail.NewDialer(opts.SMTPHost, opts.SMTPPort, opts.SMTPUser, opts.SMTPPass)
+	if opts.SMTPCert != "" {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM([]byte(opts.SMTPCert))
+		d.TLSConfig = &tls.Config{
+			ServerName: opts.SMTPHost,
+			RootCAs:    pool,
+		}
+	}
+	return &SMTPMailer{dialer: d}
+}
+
+// Dial opens the SMTP connection used by subsequent Send calls
+func (m *SMTPMailer) Dial(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	conn, err := m.dialer.Dial()
+	if err != nil {
+		return err
+	}
+	m.conn = conn
+	return nil
+}
+
+// Send delivers msg over the dialed SMTP connection, dialing first if needed
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if len(msg.Recipients) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.conn == nil {
+		if err := m.Dial(ctx); err != nil {
+			return err
+		}
+	}
+
+	gm := gomail.NewMessage()
+	gm.SetHeaders(map[string][]string{
+		"From":    {msg.Sender},
+		"Subject": {msg.Subject},
+		"To":      msg.Recipients,
+	})
+	gm.SetBody("text/html", msg.Body)
+	return gomail.Send(m.conn, gm)
+}
+
+// NullMailer logs rendered messages instead of sending them, and captures
+// them in Sent so tests can make assertions without standing up a fake SMTP
+// server. It can stand in for SMTPMailer wherever a Mailer is accepted, e.g.
+// for a --dry-run mode.
+type NullMailer struct {
+	mu   sync.Mutex
+	Sent []Message
+}
+
+// Dial is a no-op for NullMailer
+func (m *NullMailer) Dial(ctx context.Context) error {
+	return nil
+}
+
+// Send logs msg and appends it to Sent instead of delivering it
+func (m *NullMailer) Send(ctx context.Context, msg Message) error {
+	if len(msg.Recipients) == 0 {
+		return nil
+	}
+	log.Printf("dry-run: would send %q to %v:\n%s", msg.Subject, msg.Recipients, msg.Body)
+	m.mu.Lock()
+	m.Sent = append(m.Sent, msg)
+	m.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,40 @@
+package sandbox
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNullMailerCapturesMessage(t *testing.T) {
+	mailer := &NullMailer{}
+	msg := Message{
+		Sender:     "sandbox@example.gov",
+		Subject:    "test",
+		Body:       "<p>hi</p>",
+		Recipients: []string{"dev@agency.gov"},
+	}
+
+	if err := mailer.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	if len(mailer.Sent) != 1 {
+		t.Fatalf("expected 1 captured message, got %d", len(mailer.Sent))
+	}
+	if mailer.Sent[0].Subject != msg.Subject {
+		t.Errorf("expected subject %q, got %q", msg.Subject, mailer.Sent[0].Subject)
+	}
+	if len(mailer.Sent[0].Recipients) != 1 || mailer.Sent[0].Recipients[0] != "dev@agency.gov" {
+		t.Errorf("unexpected recipients: %v", mailer.Sent[0].Recipients)
+	}
+}
+
+func TestNullMailerSkipsEmptyRecipients(t *testing.T) {
+	mailer := &NullMailer{}
+	if err := mailer.Send(context.Background(), Message{Subject: "test"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if len(mailer.Sent) != 0 {
+		t.Errorf("expected no captured messages, got %d", len(mailer.Sent))
+	}
+}
@@ -2,8 +2,7 @@ package sandbox
 
 import (
 	"bytes"
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"fmt"
 	"html/template"
 	"net/mail"
@@ -12,7 +11,6 @@ import (
 	"time"
 
 	"github.com/cloudfoundry-community/go-cfclient"
-	"gopkg.in/gomail.v2"
 )
 
 // SMTPOptions describes configation for sending mail via SMTP
@@ -24,9 +22,33 @@ type SMTPOptions struct {
 	SMTPCert string `envconfig:"smtp_cert"`
 }
 
-// ListRecipients get a list of recipient emails from space roles
-func ListRecipients(userGUIDs map[string]bool, roles []cfclient.SpaceRole) (addresses, developers, managers []string) {
-	addresses = []string{}
+// ListSpaceRoles fetches the roles assigned within a space, consulting cache
+// before falling through to the CF API
+func ListSpaceRoles(ctx context.Context, client *cfclient.Client, orgGUID string, space cfclient.Space, cache *resourceCache) ([]cfclient.SpaceRole, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if cached, ok := cache.get(orgGUID, resourceKindSpaceRoles, space.Guid, now); ok {
+		return cached.([]cfclient.SpaceRole), nil
+	}
+
+	var roles []cfclient.SpaceRole
+	if err := withDeadline(ctx, client, func() error {
+		var err error
+		roles, err = client.ListSpaceRoles(space.Guid)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	cache.set(orgGUID, resourceKindSpaceRoles, space.Guid, roles, now)
+	return roles, nil
+}
+
+// ListRecipients get a list of recipient emails, each with a locale hint, from space roles
+func ListRecipients(userGUIDs map[string]bool, roles []cfclient.SpaceRole) (addresses []Recipient, developers, managers []string) {
+	addresses = []Recipient{}
 	developers = []string{}
 	managers = []string{}
 	for _, role := range roles {
@@ -34,7 +56,7 @@ func ListRecipients(userGUIDs map[string]bool, roles []cfclient.SpaceRole) (addr
 			continue
 		}
 		if _, err := mail.ParseAddress(role.Username); err == nil {
-			addresses = append(addresses, role.Username)
+			addresses = append(addresses, Recipient{Address: role.Username, Locale: localeFromUsername(role.Username)})
 		}
 		for _, roleType := range role.SpaceRoles {
 			if roleType == "space_developer" {
@@ -48,21 +70,52 @@ func ListRecipients(userGUIDs map[string]bool, roles []cfclient.SpaceRole) (addr
 }
 
 // PurgeSpace deletes a space; if the delete fails, it deletes all applications within the space
-func PurgeSpace(client *cfclient.Client, space cfclient.Space) error {
-	spaceErr := client.DeleteSpace(space.Guid, true, false)
-	if spaceErr != nil {
-		query := url.Values(map[string][]string{"q": []string{fmt.Sprintf("space_guid:%s", space.Guid)}})
-		apps, err := client.ListAppsByQuery(query)
-		if err != nil {
+func PurgeSpace(ctx context.Context, client *cfclient.Client, space cfclient.Space, cache *resourceCache) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	spaceErr := withDeadline(ctx, client, func() error {
+		return client.DeleteSpace(space.Guid, true, false)
+	})
+	if spaceErr == nil {
+		// recursive=true cascades to every app and service instance in the space
+		cache.invalidateOrg(space.OrganizationGuid)
+		return nil
+	}
+	if ctx.Err() != nil {
+		return spaceErr
+	}
+
+	cache.invalidate(space.OrganizationGuid, resourceKindSpaces)
+	query := url.Values(map[string][]string{"q": []string{fmt.Sprintf("space_guid:%s", space.Guid)}})
+	var apps []cfclient.App
+	if err := withDeadline(ctx, client, func() error {
+		var err error
+		apps, err = client.ListAppsByQuery(query)
+		return err
+	}); err != nil {
+		return err
+	}
+	for _, app := range apps {
+		if err := ctx.Err(); err != nil {
 			return err
 		}
-		for _, app := range apps {
-			if err := client.DeleteApp(app.Guid); err != nil {
-				return err
-			}
+		if err := DeleteApp(ctx, client, app, space.OrganizationGuid, cache); err != nil {
+			return err
 		}
-		return spaceErr
 	}
+	return spaceErr
+}
+
+// DeleteApp deletes an app and invalidates any cached app listing for its organization
+func DeleteApp(ctx context.Context, client *cfclient.Client, app cfclient.App, orgGUID string, cache *resourceCache) error {
+	if err := withDeadline(ctx, client, func() error {
+		return client.DeleteApp(app.Guid)
+	}); err != nil {
+		return err
+	}
+	cache.invalidate(orgGUID, resourceKindApps)
 	return nil
 }
 
@@ -75,48 +128,16 @@ func RenderTemplate(tmpl *template.Template, data map[string]interface{}) (strin
 	return buf.String(), nil
 }
 
-// SendMail sends email via SMTP
-func SendMail(
-	opts SMTPOptions,
-	sender string,
-	subject string,
-	body string,
-	recipients []string,
-) error {
-	if len(recipients) == 0 {
-		return nil
-	}
-
-	d := gomail.NewDialer(opts.SMTPHost, opts.SMTPPort, opts.SMTPUser, opts.SMTPPass)
-	if opts.SMTPCert != "" {
-		pool := x509.NewCertPool()
-		pool.AppendCertsFromPEM([]byte(opts.SMTPCert))
-		d.TLSConfig = &tls.Config{
-			ServerName: opts.SMTPHost,
-			RootCAs:    pool,
-		}
-	}
-	s, err := d.Dial()
-	if err != nil {
-		return err
-	}
-
-	m := gomail.NewMessage()
-	m.SetHeaders(map[string][]string{
-		"From":    {sender},
-		"Subject": {subject},
-		"To":      recipients,
-	})
-	m.SetBody("text/html", body)
-	return gomail.Send(s, m)
-}
-
 // ListSandboxOrgs lists all sandbox organizations
-func ListSandboxOrgs(client *cfclient.Client, prefix string) ([]cfclient.Org, error) {
+func ListSandboxOrgs(ctx context.Context, client *cfclient.Client, prefix string) ([]cfclient.Org, error) {
 	sandboxes := []cfclient.Org{}
 
-	orgs, err := client.ListOrgs()
-	if err != nil {
+	var orgs []cfclient.Org
+	if err := withDeadline(ctx, client, func() error {
+		var err error
+		orgs, err = client.ListOrgs()
+		return err
+	}); err != nil {
 		return sandboxes, err
 	}
 
@@ -129,31 +150,66 @@ func ListSandboxOrgs(client *cfclient.Client, prefix string) ([]cfclient.Org, er
 	return sandboxes, nil
 }
 
-// ListOrgResources fetches apps, service instances, and spaces within an organization
+// ListOrgResources fetches apps, service instances, and spaces within an organization,
+// consulting cache before falling through to the CF API for each resource kind
 func ListOrgResources(
+	ctx context.Context,
 	client *cfclient.Client,
 	org cfclient.Org,
+	cache *resourceCache,
 ) (
 	spaces []cfclient.Space,
 	apps []cfclient.App,
 	instances []cfclient.ServiceInstance,
 	err error,
 ) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	now := time.Now()
 	query := url.Values(map[string][]string{"q": []string{"organization_guid:" + org.Guid}})
 
-	apps, err = client.ListAppsByQuery(query)
-	if err != nil {
-		return
+	if cached, ok := cache.get(org.Guid, resourceKindApps, "", now); ok {
+		apps = cached.([]cfclient.App)
+	} else {
+		err = withDeadline(ctx, client, func() error {
+			var listErr error
+			apps, listErr = client.ListAppsByQuery(query)
+			return listErr
+		})
+		if err != nil {
+			return
+		}
+		cache.set(org.Guid, resourceKindApps, "", apps, now)
 	}
 
-	instances, err = client.ListServiceInstancesByQuery(query)
-	if err != nil {
-		return
+	if cached, ok := cache.get(org.Guid, resourceKindInstances, "", now); ok {
+		instances = cached.([]cfclient.ServiceInstance)
+	} else {
+		err = withDeadline(ctx, client, func() error {
+			var listErr error
+			instances, listErr = client.ListServiceInstancesByQuery(query)
+			return listErr
+		})
+		if err != nil {
+			return
+		}
+		cache.set(org.Guid, resourceKindInstances, "", instances, now)
 	}
 
-	spaces, err = client.OrgSpaces(org.Guid)
-	if err != nil {
-		return
+	if cached, ok := cache.get(org.Guid, resourceKindSpaces, "", now); ok {
+		spaces = cached.([]cfclient.Space)
+	} else {
+		err = withDeadline(ctx, client, func() error {
+			var spacesErr error
+			spaces, spacesErr = client.OrgSpaces(org.Guid)
+			return spacesErr
+		})
+		if err != nil {
+			return
+		}
+		cache.set(org.Guid, resourceKindSpaces, "", spaces, now)
 	}
 
 	return
@@ -199,6 +255,7 @@ type SpaceDetails struct {
 
 // ListPurgeSpaces identifies spaces that will be notified or purged
 func ListPurgeSpaces(
+	ctx context.Context,
 	spaces []cfclient.Space,
 	apps []cfclient.App,
 	instances []cfclient.ServiceInstance,
@@ -213,6 +270,10 @@ func ListPurgeSpaces(
 ) {
 	var firstResource time.Time
 	for _, space := range spaces {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
 		firstResource, err = GetFirstResource(space, apps, instances)
 		if err != nil {
 			return